@@ -3,29 +3,114 @@ package controller
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
+	"time"
 
+	"golang.org/x/time/rate"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/informers"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
-	"k8s.io/klog"
+	"k8s.io/klog/v2"
+
+	examplev1alpha1 "github.com/camuzard/crd-watcher/pkg/apis/example.com/v1alpha1"
+	"github.com/camuzard/crd-watcher/pkg/generated/clientset/versioned"
+	exampleinformers "github.com/camuzard/crd-watcher/pkg/generated/informers/externalversions"
+	listers "github.com/camuzard/crd-watcher/pkg/generated/listers/example.com/v1alpha1"
 )
 
-// Controller struct to hold the components needed to watch Deployments, clientset is the interface to the Kubernetes API.
-// *kubernetes.Clientset is a typed client for interacting with Kubernetes resources like Deployments. We’ll use it to set up the watcher.
+// controllerKind is the GroupVersionKind used to build owner references back to the owning Foo.
+var controllerKind = examplev1alpha1.SchemeGroupVersion.WithKind("Foo")
+
+// FieldManager identifies this controller's writes to the API server, so that server-side apply
+// conflicts can be attributed to controller-playground rather than some other actor.
+const FieldManager = "controller-playground"
+
+// controllerAgentName is the component name events emitted by this controller are recorded under.
+const controllerAgentName = "controller-playground"
+
+// ControllerOptions tunes the workqueue's rate limiter, the number of worker goroutines, and the
+// leader-election lock. BaseDelay/MaxDelay configure the exponential-backoff limiter applied to a
+// key after each failure; QPS/Burst configure the token-bucket limiter applied across all keys.
+type ControllerOptions struct {
+	RateLimiterBaseDelay time.Duration
+	RateLimiterMaxDelay  time.Duration
+	RateLimiterQPS       float64
+	RateLimiterBurst     int
+
+	// Workers is the number of goroutines processing the workqueue concurrently.
+	Workers int
+
+	// LeaderElectionNamespace and LeaderElectionName identify the Lease used to elect a single
+	// active controller when multiple replicas are running. Identity identifies this replica in
+	// that Lease; if empty, the host's hostname is used.
+	LeaderElectionNamespace string
+	LeaderElectionName      string
+	Identity                string
+}
+
+// DefaultControllerOptions returns the rate limiter tuning, worker count and leader-election lock
+// used when no overrides are given.
+func DefaultControllerOptions() ControllerOptions {
+	return ControllerOptions{
+		RateLimiterBaseDelay: 5 * time.Millisecond,
+		RateLimiterMaxDelay:  1000 * time.Second,
+		RateLimiterQPS:       50,
+		RateLimiterBurst:     300,
+
+		Workers: 2,
+
+		LeaderElectionNamespace: "default",
+		LeaderElectionName:      "controller-playground",
+	}
+}
+
+// Controller watches Foo resources, creates and updates a Deployment named by
+// Spec.DeploymentName for each one, and reports the Deployment's observed
+// availability back onto Foo.Status. clientset is the interface to the
+// Kubernetes API, exampleclientset is the interface to the Foo API.
 type Controller struct {
-	clientset *kubernetes.Clientset
-	// The controller enqueues string keys from cache.MetaNamespaceKeyFunc, so we’ll use TypedRateLimitingInterface[string]
-	queue workqueue.TypedRateLimitingInterface[string]
+	clientset        *kubernetes.Clientset
+	exampleclientset versioned.Interface
+
+	deploymentsLister appslisters.DeploymentLister
+	deploymentsSynced cache.InformerSynced
+	foosLister        listers.FooLister
+	foosSynced        cache.InformerSynced
+
+	// The controller enqueues cache.ObjectName keys, built from cache.MetaNamespaceKeyFunc via
+	// cache.ParseObjectName, so items can be looked up without re-splitting a "ns/name" string.
+	queue workqueue.TypedRateLimitingInterface[cache.ObjectName]
+
+	// recorder records Kubernetes events describing reconciliation outcomes against the Deployment
+	// and Foo objects involved, so they show up in `kubectl describe`.
+	recorder record.EventRecorder
+
+	opts ControllerOptions
 }
 
-// NewController takes a kubeconfig string (path to the kubeconfig file, or empty for in-cluster config).
+// NewController takes a context.Context (used to derive the contextual logger), a kubeconfig
+// string (path to the kubeconfig file, or empty for in-cluster config), and ControllerOptions
+// tuning the workqueue's rate limiter, the worker count and the leader-election lock.
 // It returns a *Controller (pointer to the Controller struct) and an error for initialization failures.
-func NewController(kubeconfig string) (*Controller, error) {
+func NewController(ctx context.Context, kubeconfig string, opts ControllerOptions) (*Controller, error) {
+	logger := klog.FromContext(ctx)
+
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
 		config, err = rest.InClusterConfig()
@@ -35,132 +120,385 @@ func NewController(kubeconfig string) (*Controller, error) {
 	}
 
 	// Initialize the Kubernetes clientset using the provided kubeconfig.
+	logger.V(4).Info("Building Kubernetes clientset")
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a type-safe rate-limiting queue for string items.
-	queue := workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
+	// Initialize the generated clientset for our Foo custom resource.
+	exampleclientset, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Register our types with client-go's default scheme so the event recorder below can attach
+	// events to Foo objects, not just the built-in types it already knows about.
+	utilruntime.Must(examplev1alpha1.AddToScheme(scheme.Scheme))
 
-	// We create a new Controller instance, setting its clientset field to the initialized clientset.
-	// Return a pointer to the Controller, and nil for the error.
+	logger.V(4).Info("Creating event broadcaster")
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
+
+	// Create a type-safe rate-limiting queue for cache.ObjectName items, combining per-key
+	// exponential backoff with an overall token-bucket rate limit.
+	rateLimiter := workqueue.NewTypedMaxOfRateLimiter[cache.ObjectName](
+		workqueue.NewTypedItemExponentialFailureRateLimiter[cache.ObjectName](opts.RateLimiterBaseDelay, opts.RateLimiterMaxDelay),
+		&workqueue.TypedBucketRateLimiter[cache.ObjectName]{Limiter: rate.NewLimiter(rate.Limit(opts.RateLimiterQPS), opts.RateLimiterBurst)},
+	)
+	queue := workqueue.NewTypedRateLimitingQueue[cache.ObjectName](rateLimiter)
+
+	// We create a new Controller instance, setting its clientset fields to the initialized clientsets.
+	// Listers and informers are wired up in runWorkers, once this replica is leading and we have
+	// a context to start the shared informer factories against.
 	return &Controller{
-		clientset: clientset,
-		queue:     queue,
+		clientset:        clientset,
+		exampleclientset: exampleclientset,
+		queue:            queue,
+		recorder:         recorder,
+		opts:             opts,
 	}, nil
 }
 
-// This method starts the controller and sets up the watcher for Deployments.
-// It takes a context.Context for cancellation and returns an error if something goes wrong.
+// Run acquires the leader-election Lease configured by ControllerOptions and, once leading,
+// starts the Foo and Deployment informers and worker pool. It blocks until ctx is cancelled, then
+// shuts the workqueue down and waits for in-flight workers to drain before returning.
 func (c *Controller) Run(ctx context.Context) error {
-	// Creating an informer in the argocd namespace using the k8s watch API. Informers caches resources locally and provide event handlers.
-	informerFactory := informers.NewSharedInformerFactoryWithOptions(c.clientset, 0, informers.WithNamespace("test"))
-	deploymentInformer := informerFactory.Apps().V1().Deployments().Informer()
-
-	// AddEventHandler to registers callbacks for Add, Update, and Delete events.
-	// cache.ResourceEventHandlerFuncs, struct from client-go that defines event handler functions.
-	deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {
-			// Convert our resource object to a string key
-			key, err := cache.MetaNamespaceKeyFunc(obj)
-			if err == nil {
-				// Add the key to the queue for processing
-				c.queue.Add(key)
-			}
+	logger := klog.FromContext(ctx)
+
+	id := c.opts.Identity
+	if id == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("failed to determine leader election identity: %w", err)
+		}
+		id = hostname
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      c.opts.LeaderElectionName,
+			Namespace: c.opts.LeaderElectionNamespace,
+		},
+		Client: c.clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity:      id,
+			EventRecorder: c.recorder,
 		},
+	}
+
+	// leaderelection.LeaderElector.Run starts OnStartedLeading in its own goroutine and returns as
+	// soon as the lease is lost, without waiting for that goroutine to finish. We track it with our
+	// own WaitGroup so Run doesn't return - and the process doesn't exit - before runWorkers has
+	// actually drained.
+	var wg sync.WaitGroup
+	var runErr error
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				wg.Add(1)
+				defer wg.Done()
+				logger.Info("Started leading", "identity", id)
+				if err := c.runWorkers(ctx); err != nil {
+					logger.Error(err, "Controller exited with error")
+					runErr = err
+				}
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Stopped leading", "identity", id)
+				c.queue.ShutDown()
+			},
+			OnNewLeader: func(identity string) {
+				if identity == id {
+					return
+				}
+				logger.Info("New leader elected", "identity", identity)
+			},
+		},
+	})
+
+	wg.Wait()
+	return runErr
+}
+
+// runWorkers sets up the Foo and Deployment informers, waits for their caches to sync, then runs
+// c.opts.Workers worker goroutines processing the workqueue until ctx is cancelled, at which point
+// it shuts the queue down and waits for them to drain.
+func (c *Controller) runWorkers(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	exampleInformerFactory := exampleinformers.NewSharedInformerFactory(c.exampleclientset, 0)
+	fooInformer := exampleInformerFactory.Example().V1alpha1().Foos()
+	c.foosLister = fooInformer.Lister()
+	c.foosSynced = fooInformer.Informer().HasSynced
+
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(c.clientset, 0)
+	deploymentInformer := kubeInformerFactory.Apps().V1().Deployments()
+	c.deploymentsLister = deploymentInformer.Lister()
+	c.deploymentsSynced = deploymentInformer.Informer().HasSynced
+
+	// AddEventHandler registers callbacks for Add and Update events on Foo, the primary resource.
+	fooInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { c.enqueueFoo(obj) },
 		UpdateFunc: func(oldObj, newObj interface{}) {
-			key, err := cache.MetaNamespaceKeyFunc(newObj)
-			if err == nil {
-				c.queue.Add(key)
-			}
+			c.enqueueFoo(newObj)
 		},
-		DeleteFunc: func(obj interface{}) {
-			key, err := cache.MetaNamespaceKeyFunc(obj)
-			if err == nil {
-				c.queue.Add(key)
+	})
+
+	// Deployments are owned by a Foo, so Deployment events are translated back into the owning
+	// Foo's key and re-enqueued the same way sample-controller's handleObject does.
+	deploymentInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { c.handleDeployment(ctx, obj) },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			newDepl := newObj.(*appsv1.Deployment)
+			oldDepl := oldObj.(*appsv1.Deployment)
+			if newDepl.ResourceVersion == oldDepl.ResourceVersion {
+				// Periodic resync will send update events for all known Deployments. Two different
+				// versions of the same Deployment will always have different ResourceVersions.
+				return
 			}
+			c.handleDeployment(ctx, newObj)
 		},
+		DeleteFunc: func(obj interface{}) { c.handleDeployment(ctx, obj) },
 	})
 
-	// Starts the informer in a separate goroutine.
-	go deploymentInformer.Run(ctx.Done())
+	// Starts the informers in separate goroutines.
+	go exampleInformerFactory.Start(ctx.Done())
+	go kubeInformerFactory.Start(ctx.Done())
 
-	// Ensure the informer’s cache is populated before proceeding, ie. it has the current state of all Deployments.
-	if !cache.WaitForCacheSync(ctx.Done(), deploymentInformer.HasSynced) {
+	// Ensure both informers’ caches are populated before proceeding.
+	logger.V(4).Info("Waiting for informer caches to sync")
+	if !cache.WaitForCacheSync(ctx.Done(), c.foosSynced, c.deploymentsSynced) {
 		return fmt.Errorf("failed to sync informer cache")
 	}
 
-	// Calling processNextItem, which handles one key at a time.
-	for c.processNextItem(ctx) {
+	workers := c.opts.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	logger.Info("Starting workers", "count", workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, c.runWorker, time.Second)
+		}()
 	}
 
-	// Clean exit when the queue is shut down.
+	<-ctx.Done()
+	logger.Info("Shutting down workers")
+	c.queue.ShutDown()
+	wg.Wait()
+
 	return nil
 }
 
+// runWorker processes items from the queue until it is shut down.
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextItem(ctx) {
+	}
+}
+
+// enqueueFoo takes a Foo resource and converts it into a cache.ObjectName which is then put onto
+// the workqueue.
+func (c *Controller) enqueueFoo(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	objName, err := cache.ParseObjectName(key)
+	if err != nil {
+		return
+	}
+	c.queue.Add(objName)
+}
+
+// handleDeployment walks a Deployment's OwnerReferences looking for a Foo, and if found enqueues
+// that Foo so it gets re-synced. This lets Deployment changes (including deletes performed by
+// something other than this controller) drive reconciliation of the owning Foo.
+func (c *Controller) handleDeployment(ctx context.Context, obj interface{}) {
+	logger := klog.FromContext(ctx)
+
+	deployment, ok := obj.(*appsv1.Deployment)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			logger.Error(nil, "error decoding object, invalid type")
+			return
+		}
+		deployment, ok = tombstone.Obj.(*appsv1.Deployment)
+		if !ok {
+			logger.Error(nil, "error decoding object tombstone, invalid type")
+			return
+		}
+	}
+
+	ownerRef := metav1.GetControllerOf(deployment)
+	if ownerRef == nil || ownerRef.Kind != controllerKind.Kind {
+		return
+	}
+
+	foo, err := c.foosLister.Foos(deployment.Namespace).Get(ownerRef.Name)
+	if err != nil {
+		logger.V(4).Info("ignoring orphaned Deployment", "deployment", klog.KObj(deployment), "owner", ownerRef.Name)
+		return
+	}
+
+	c.enqueueFoo(foo)
+}
+
 // Processes one item from the queue. Returns false if the queue is shut down, true to continue.
 func (c *Controller) processNextItem(ctx context.Context) bool {
 	// Get the next key from the queue.
-	key, quit := c.queue.Get()
+	objName, quit := c.queue.Get()
 	if quit {
 		return false // Queue has been shut down
 	}
 	// Mark the key as processed, removing it from the queue’s active set.
-	defer c.queue.Done(key)
+	defer c.queue.Done(objName)
+
+	logger := klog.FromContext(ctx).WithValues("deployment", objName)
+	ctx = klog.NewContext(ctx, logger)
 
-	err := c.processItem(ctx, key)
+	err := c.processItem(ctx, objName)
 	// If processing fails, re-queue the key with rate limiting.
 	if err != nil {
-		c.queue.AddRateLimited(key)
-		klog.Errorf("Error processing %s: %v", key, err)
+		c.queue.AddRateLimited(objName)
+		logger.Error(err, "Error processing item")
 		return true
 	}
 
 	// Mark the item as done.
-	c.queue.Forget(key)
+	c.queue.Forget(objName)
 	return true
 }
 
-func (c *Controller) processItem(ctx context.Context, key string) error {
-	// Split the key into namespace and name.
-	namespace, name, err := cache.SplitMetaNamespaceKey(key)
-	if err != nil {
-		return err
-	}
+func (c *Controller) processItem(ctx context.Context, objName cache.ObjectName) error {
+	logger := klog.FromContext(ctx)
+	namespace, name := objName.Namespace, objName.Name
 
-	// Fetch the latest Deployment from the API server using the clientset.
-	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	// Fetch the latest Foo from the informer cache.
+	foo, err := c.foosLister.Foos(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			klog.Infof("Deployment deleted: %s/%s", namespace, name)
+			logger.Info("Foo deleted")
 			return nil
 		}
 		return err
 	}
 
-	replicas := int32(0)
-	if deployment.Spec.Replicas != nil {
-		replicas = *deployment.Spec.Replicas
+	deploymentName := foo.Spec.DeploymentName
+	if deploymentName == "" {
+		logger.Error(nil, "Foo is missing spec.deploymentName")
+		return nil
+	}
+
+	deployment, err := c.deploymentsLister.Deployments(namespace).Get(deploymentName)
+	if errors.IsNotFound(err) {
+		logger.Info("Creating Deployment", "deploymentName", deploymentName)
+		deployment, err = c.clientset.AppsV1().Deployments(namespace).Create(ctx, newDeployment(foo), metav1.CreateOptions{FieldManager: FieldManager})
+		if err != nil {
+			c.recorder.Eventf(foo, corev1.EventTypeWarning, "UpdateFailed", "Failed to create Deployment %s: %v", deploymentName, err)
+			return err
+		}
+		c.recorder.Eventf(deployment, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentName, *deployment.Spec.Replicas)
+		c.recorder.Eventf(foo, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentName, *deployment.Spec.Replicas)
+	} else if err != nil {
+		return err
+	}
+
+	// If this Deployment is not controlled by this Foo resource, bail out with an error; the key is
+	// then logged and re-queued with rate limiting.
+	if !metav1.IsControlledBy(deployment, foo) {
+		return fmt.Errorf("Deployment %s/%s already exists and is not owned by Foo %s", namespace, deploymentName, objName)
 	}
-	klog.Infof("Deployment: %s/%s, Replicas=%d", namespace, name, replicas)
 
-	// Ensure we run 2 replicas of the Deployment.
-	if replicas < 2 {
-		klog.Infof("Scaling replicas to 2 for %s/%s", namespace, name)
+	desiredReplicas := int32(1)
+	if foo.Spec.Replicas != nil {
+		desiredReplicas = *foo.Spec.Replicas
+	}
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != desiredReplicas {
+		logger.Info("Scaling Deployment", "deploymentName", deploymentName, "replicas", desiredReplicas)
 
 		// Create a copy of the Deployment to avoid modifying the informer’s cached object, which could cause issues.
 		updatedDeployment := deployment.DeepCopy()
-		newReplicas := int32(2)
-		updatedDeployment.Spec.Replicas = &newReplicas
+		updatedDeployment.Spec.Replicas = &desiredReplicas
 		// Update the Deployment via the API server.
-		_, err = c.clientset.AppsV1().Deployments(namespace).Update(ctx, updatedDeployment, metav1.UpdateOptions{})
+		deployment, err = c.clientset.AppsV1().Deployments(namespace).Update(ctx, updatedDeployment, metav1.UpdateOptions{FieldManager: FieldManager})
 		if err != nil {
-			klog.Errorf("Failed to update Deployment %s/%s: %v", namespace, name, err)
+			logger.Error(err, "Failed to update Deployment", "deploymentName", deploymentName)
+			c.recorder.Eventf(updatedDeployment, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %s: %v", deploymentName, err)
+			c.recorder.Eventf(foo, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %s: %v", deploymentName, err)
 			// If another process updates the Deployment concurrently, the work queue will retry, fetching the latest state.
 			return err
 		}
+		c.recorder.Eventf(deployment, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentName, desiredReplicas)
+		c.recorder.Eventf(foo, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentName, desiredReplicas)
 	}
 
-	return nil
+	return c.updateFooStatus(ctx, foo, deployment)
+}
+
+// updateFooStatus writes the observed AvailableReplicas of deployment back onto foo.Status.
+func (c *Controller) updateFooStatus(ctx context.Context, foo *examplev1alpha1.Foo, deployment *appsv1.Deployment) error {
+	if foo.Status.AvailableReplicas == deployment.Status.AvailableReplicas {
+		return nil
+	}
+
+	// Never modify the informer's copy; work on a deep copy instead.
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status.AvailableReplicas = deployment.Status.AvailableReplicas
+	_, err := c.exampleclientset.ExampleV1alpha1().Foos(foo.Namespace).UpdateStatus(ctx, fooCopy, metav1.UpdateOptions{FieldManager: FieldManager})
+	return err
+}
+
+// newDeployment creates a new Deployment for a Foo resource, setting an owner reference back to
+// the Foo so a Deployment delete triggers re-creation and Deployment events re-enqueue the Foo.
+func newDeployment(foo *examplev1alpha1.Foo) *appsv1.Deployment {
+	labels := map[string]string{
+		"app":        "nginx",
+		"controller": foo.Name,
+	}
+	replicas := int32(1)
+	if foo.Spec.Replicas != nil {
+		replicas = *foo.Spec.Replicas
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      foo.Spec.DeploymentName,
+			Namespace: foo.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(foo, controllerKind),
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "nginx",
+							Image: "nginx:latest",
+						},
+					},
+				},
+			},
+		},
+	}
 }
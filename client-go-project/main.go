@@ -1,24 +1,36 @@
 package main
 
 import (
-	"context"
 	"flag"
+	"os"
 
 	"github.com/camuzard/crd-watcher/client-go-project/controller"
+	"github.com/camuzard/crd-watcher/client-go-project/signals"
 	"k8s.io/klog/v2"
 )
 
 func main() {
-	kubeconfig := flag.String("kubeconfig", "", "Path to kubeconfig file")
-	flag.Parse()
+	// Create a custom FlagSet so klog's flags can be bound alongside our own.
+	fs := flag.NewFlagSet("crd-watcher", flag.ExitOnError)
+	kubeconfig := fs.String("kubeconfig", "", "Path to kubeconfig file")
+	workers := fs.Int("workers", controller.DefaultControllerOptions().Workers, "Number of worker goroutines processing the workqueue")
+	klog.InitFlags(fs)
+	fs.Parse(os.Args[1:])
 
-	ctrl, err := controller.NewController(*kubeconfig)
+	logger := klog.Background()
+	ctx := klog.NewContext(signals.SetupSignalHandler(), logger)
+
+	opts := controller.DefaultControllerOptions()
+	opts.Workers = *workers
+
+	ctrl, err := controller.NewController(ctx, *kubeconfig, opts)
 	if err != nil {
-		klog.Fatalf("Failed to create controller: %v", err)
+		logger.Error(err, "Failed to create controller")
+		os.Exit(1)
 	}
 
-	ctx := context.Background()
 	if err := ctrl.Run(ctx); err != nil {
-		klog.Fatalf("Failed to run controller: %v", err)
+		logger.Error(err, "Failed to run controller")
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,33 @@
+// Package signals provides a SIGTERM/SIGINT-aware context for graceful shutdown, mirroring
+// k8s.io/sample-controller's pkg/signals helper.
+package signals
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+var onlyOneSignalHandler = make(chan struct{})
+
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// SetupSignalHandler returns a context that is cancelled on SIGTERM or SIGINT. A second signal
+// terminates the process immediately, in case graceful shutdown gets stuck. It panics if called
+// more than once.
+func SetupSignalHandler() context.Context {
+	close(onlyOneSignalHandler) // panics when called twice
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, shutdownSignals...)
+	go func() {
+		<-c
+		cancel()
+		<-c
+		os.Exit(1) // second signal, exit directly
+	}()
+
+	return ctx
+}
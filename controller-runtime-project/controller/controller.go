@@ -4,66 +4,198 @@ import (
 	"context"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	examplev1alpha1 "github.com/camuzard/crd-watcher/pkg/apis/example.com/v1alpha1"
 )
 
-type DeploymentReconciler struct {
+// FieldManager identifies this controller's writes to the API server, so that server-side apply
+// conflicts can be attributed to controller-playground rather than some other actor.
+const FieldManager = "controller-playground"
+
+// controllerAgentName is the component name events emitted by this controller are recorded under.
+const controllerAgentName = "controller-playground"
+
+// FooReconciler reconciles a Foo object, creating and updating a Deployment named by
+// Spec.DeploymentName and owned by the Foo, and reporting the Deployment's observed
+// availability back onto Foo.Status.
+type FooReconciler struct {
 	// Client is controller-runtime’s API client, replacing client-go’s Clientset. It provides methods like Get, Update, and handles caching.
 	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder records Kubernetes events describing reconciliation outcomes against the Deployment
+	// and Foo objects involved, so they show up in `kubectl describe`.
+	Recorder record.EventRecorder
 }
 
 // Implements the reconciliation logic, called for each event.
-func (r *DeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	// Create an empty Deployment object to store the fetched resource
-	deployment := &appsv1.Deployment{}
-	// req ctrl.Request contains namespace and name,excample test/test-deployment
-	// Get fetches the Deployment using the client, which uses the manager’s cache, reducing API calls compared to client-go’s Clientset.
-	if err := r.Get(ctx, req.NamespacedName, deployment); err != nil {
+func (r *FooReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := klog.FromContext(ctx)
+
+	// Create an empty Foo object to store the fetched resource.
+	foo := &examplev1alpha1.Foo{}
+	// req ctrl.Request contains namespace and name, e.g. test/my-foo
+	if err := r.Get(ctx, req.NamespacedName, foo); err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			klog.Errorf("Error fetching Deployment %s: %v", req.NamespacedName, err)
+			logger.Error(err, "Error fetching Foo")
 			return ctrl.Result{}, err
 		}
-		klog.Infof("Deployment deleted: %s", req.NamespacedName)
+		logger.Info("Foo deleted")
 		return ctrl.Result{}, nil // No requeue
 	}
 
-	replicas := int32(0)
-	if deployment.Spec.Replicas != nil {
-		replicas = *deployment.Spec.Replicas
+	if foo.Spec.DeploymentName == "" {
+		logger.Error(nil, "Foo is missing spec.deploymentName")
+		return ctrl.Result{}, nil
 	}
-	klog.Infof("Deployment: %s, Replicas=%d", req.NamespacedName, replicas)
 
-	if replicas < 2 {
-		klog.Infof("Scaling replicas to 2 for %s", req.NamespacedName)
+	deployment := &appsv1.Deployment{}
+	deploymentKey := client.ObjectKey{Namespace: foo.Namespace, Name: foo.Spec.DeploymentName}
+	err := r.Get(ctx, deploymentKey, deployment)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Creating Deployment", "deployment", deploymentKey)
+		deployment = newDeployment(foo)
+		if err := controllerutil.SetControllerReference(foo, deployment, r.Scheme); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Create(ctx, deployment, client.FieldOwner(FieldManager)); err != nil {
+			logger.Error(err, "Failed to create Deployment", "deployment", deploymentKey)
+			r.Recorder.Eventf(foo, corev1.EventTypeWarning, "UpdateFailed", "Failed to create Deployment %s: %v", deploymentKey, err)
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(deployment, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentKey, *deployment.Spec.Replicas)
+		r.Recorder.Eventf(foo, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentKey, *deployment.Spec.Replicas)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		logger.Error(err, "Error fetching Deployment", "deployment", deploymentKey)
+		return ctrl.Result{}, err
+	}
+
+	// If this Deployment is not controlled by this Foo resource, log an error and bail out without requeueing.
+	if !metav1.IsControlledBy(deployment, foo) {
+		logger.Error(nil, "Deployment already exists and is not owned by this Foo", "deployment", deploymentKey)
+		return ctrl.Result{}, nil
+	}
+
+	desiredReplicas := int32(1)
+	if foo.Spec.Replicas != nil {
+		desiredReplicas = *foo.Spec.Replicas
+	}
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != desiredReplicas {
+		logger.Info("Scaling Deployment", "deployment", deploymentKey, "replicas", desiredReplicas)
 
-		// Create a copy of the Deployment to avoid modifying the informer’s cached object, which could cause issues.
+		// Create a copy of the Deployment to avoid modifying the cached object, which could cause issues.
 		updatedDeployment := deployment.DeepCopy()
-		newReplicas := int32(2)
-		updatedDeployment.Spec.Replicas = &newReplicas
+		updatedDeployment.Spec.Replicas = &desiredReplicas
 		// Update the Deployment using the client’s Update method.
 		// The client handles retries and conflicts internally, unlike client-go’s raw API calls.
-		if err := r.Update(ctx, updatedDeployment); err != nil {
-			klog.Errorf("Failed to update Deployment %s to 2 replicas: %v", req.NamespacedName, err)
-			return ctrl.Result{}, err // No requeue
+		if err := r.Update(ctx, updatedDeployment, client.FieldOwner(FieldManager)); err != nil {
+			logger.Error(err, "Failed to update Deployment", "deployment", deploymentKey, "replicas", desiredReplicas)
+			r.Recorder.Eventf(updatedDeployment, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %s: %v", deploymentKey, err)
+			r.Recorder.Eventf(foo, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %s: %v", deploymentKey, err)
+			return ctrl.Result{}, err
 		}
+		deployment = updatedDeployment
+		r.Recorder.Eventf(deployment, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentKey, desiredReplicas)
+		r.Recorder.Eventf(foo, corev1.EventTypeNormal, "ScaledUp", "Scaled up Deployment %s to %d replicas", deploymentKey, desiredReplicas)
+	}
+
+	return ctrl.Result{}, r.updateFooStatus(ctx, foo, deployment)
+}
+
+// updateFooStatus writes the observed AvailableReplicas of deployment back onto foo.Status.
+func (r *FooReconciler) updateFooStatus(ctx context.Context, foo *examplev1alpha1.Foo, deployment *appsv1.Deployment) error {
+	if foo.Status.AvailableReplicas == deployment.Status.AvailableReplicas {
+		return nil
 	}
 
-	return ctrl.Result{}, nil // No requeue
+	// Never modify the cached copy; work on a deep copy instead.
+	fooCopy := foo.DeepCopy()
+	fooCopy.Status.AvailableReplicas = deployment.Status.AvailableReplicas
+	return r.Status().Update(ctx, fooCopy, client.FieldOwner(FieldManager))
 }
 
-// Configures the controller with the manager, to watch resources and handle events.
+// newDeployment creates a new Deployment for a Foo resource. The caller is responsible for
+// setting the owner reference back to the Foo before creating it.
+func newDeployment(foo *examplev1alpha1.Foo) *appsv1.Deployment {
+	labels := map[string]string{
+		"app":        "nginx",
+		"controller": foo.Name,
+	}
+	replicas := int32(1)
+	if foo.Spec.Replicas != nil {
+		replicas = *foo.Spec.Replicas
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      foo.Spec.DeploymentName,
+			Namespace: foo.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "nginx",
+							Image: "nginx:latest",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configures the controller with the manager, to watch Foo as the primary resource and own any
+// Deployment it creates, so Deployment events re-enqueue the owning Foo.
 func SetupWithManager(mgr ctrl.Manager) error {
+	recorder, err := newEventRecorder(mgr.GetConfig(), mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		// For specifies the primary resource to watch
-		For(&appsv1.Deployment{}).
-		WithEventFilter(predicate.Funcs{
-			CreateFunc: func(e event.CreateEvent) bool { return e.Object.GetNamespace() == "test" },
-			UpdateFunc: func(e event.UpdateEvent) bool { return e.ObjectNew.GetNamespace() == "test" },
-			DeleteFunc: func(e event.DeleteEvent) bool { return e.Object.GetNamespace() == "test" },
-		}).
-		Complete(&DeploymentReconciler{Client: mgr.GetClient()})
+		// For specifies the primary resource to watch.
+		For(&examplev1alpha1.Foo{}).
+		// Owns re-enqueues the owning Foo whenever a Deployment it owns changes.
+		Owns(&appsv1.Deployment{}).
+		Complete(&FooReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: recorder})
+}
+
+// newEventRecorder builds an event recorder that publishes reconciliation outcomes as Kubernetes
+// events, using a plain client-go clientset since controller-runtime's client doesn't expose the
+// events sub-resource required by record.EventSinkImpl.
+func newEventRecorder(cfg *rest.Config, scheme *runtime.Scheme) (record.EventRecorder, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	utilruntime.Must(examplev1alpha1.AddToScheme(scheme))
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	return eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: controllerAgentName}), nil
 }
@@ -5,40 +5,52 @@ import (
 	"os"
 
 	"github.com/camuzard/crd-watcher/controller-runtime-project/controller"
+	examplev1alpha1 "github.com/camuzard/crd-watcher/pkg/apis/example.com/v1alpha1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
+func init() {
+	utilruntime.Must(examplev1alpha1.AddToScheme(scheme.Scheme))
+}
+
 func main() {
-	// Create a custom FlagSet to avoid conflicts
+	// Create a custom FlagSet so klog's flags can be bound alongside our own.
 	fs := flag.NewFlagSet("crd-watcher", flag.ExitOnError)
 	kubeconfig := fs.String("kubeconfig", "", "Path to kubeconfig")
+	klog.InitFlags(fs)
 	fs.Parse(os.Args[1:])
 
-	// Initialize klog without flag parsing
-	klog.InitFlags(nil)
+	logger := klog.Background()
+	ctx := klog.NewContext(ctrl.SetupSignalHandler(), logger)
 
 	// Load kubeconfig or in-cluster config
 	cfg, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
 	if err != nil {
 		cfg, err = config.GetConfig()
 		if err != nil {
-			klog.Fatalf("Failed to load config: %v", err)
+			logger.Error(err, "Failed to load config")
+			os.Exit(1)
 		}
 	}
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{})
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme.Scheme})
 	if err != nil {
-		klog.Fatalf("Failed to create manager: %v", err)
+		logger.Error(err, "Failed to create manager")
+		os.Exit(1)
 	}
 
 	if err := controller.SetupWithManager(mgr); err != nil {
-		klog.Fatalf("Failed to setup controller: %v", err)
+		logger.Error(err, "Failed to setup controller")
+		os.Exit(1)
 	}
 
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		klog.Fatalf("Failed to start manager: %v", err)
+	if err := mgr.Start(ctx); err != nil {
+		logger.Error(err, "Failed to start manager")
+		os.Exit(1)
 	}
 }